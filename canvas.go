@@ -0,0 +1,259 @@
+package pixel
+
+import (
+	"image"
+	"math"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// Canvas is a software (CPU) Target. It rasterizes Triangles directly into an *image.NRGBA,
+// so Sprites and Polygons can be drawn without an OpenGL context. This makes it useful for
+// headless tests, screenshot generation, and tools.
+//
+// A Canvas is created with a fixed Rect of world-space bounds. That Rect is mapped onto the
+// backing image, whose size is the (rounded up) size of the Rect. The Y axis of the world space
+// points up, while the backing image's Y axis points down, so Canvas flips Y when rasterizing.
+//
+// Create a Canvas with NewCanvas, then Draw Sprites/Polygons onto it like any other Target and
+// read back the result with Image.
+type Canvas struct {
+	pix       *image.NRGBA
+	bounds    Rect
+	pic       *Picture
+	transform *TransformStack
+	filter    Filter
+	composite CompositeOp
+}
+
+// NewCanvas creates a new Canvas with the given world-space bounds. The Canvas starts out fully
+// transparent.
+func NewCanvas(bounds Rect) *Canvas {
+	w, h := bounds.Size.XY()
+	img := image.NewNRGBA(image.Rect(0, 0, int(math.Ceil(w)), int(math.Ceil(h))))
+	return &Canvas{
+		pix:    img,
+		bounds: bounds,
+		filter: FilterBilinear,
+	}
+}
+
+// Bounds returns the world-space bounds of the Canvas.
+func (c *Canvas) Bounds() Rect {
+	return c.bounds
+}
+
+// Image returns the backing *image.NRGBA that Canvas rasterizes into. The returned image is
+// shared with the Canvas; do not mutate it concurrently with drawing.
+func (c *Canvas) Image() *image.NRGBA {
+	return c.pix
+}
+
+// Clear fills the whole Canvas with the given color.
+func (c *Canvas) Clear(color NRGBA) {
+	r, g, b, a := uint8(color.R*255), uint8(color.G*255), uint8(color.B*255), uint8(color.A*255)
+	bounds := c.pix.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			i := c.pix.PixOffset(x, y)
+			c.pix.Pix[i+0], c.pix.Pix[i+1], c.pix.Pix[i+2], c.pix.Pix[i+3] = r, g, b, a
+		}
+	}
+}
+
+// SetPicture sets the Picture that subsequently drawn textured Triangles sample from. A nil
+// Picture disables texturing; Triangles are then drawn using their vertex Color alone.
+func (c *Canvas) SetPicture(pic *Picture) {
+	c.pic = pic
+}
+
+// MakeTriangles returns a TargetTriangles that rasterizes t onto this Canvas whenever its Draw
+// method is called.
+func (c *Canvas) MakeTriangles(t Triangles) TargetTriangles {
+	ct := &canvasTriangles{
+		TrianglesData: MakeTrianglesData(t.Len()),
+		canvas:        c,
+	}
+	ct.Update(t)
+	return ct
+}
+
+// canvasTriangles is the Canvas's implementation of TargetTriangles: a copy of the Triangles
+// data together with the Canvas it draws into.
+type canvasTriangles struct {
+	TrianglesData
+	canvas *Canvas
+}
+
+// Draw rasterizes every triangle (each group of 3 consecutive vertices) onto the parent Canvas,
+// applying the Canvas's current TransformStack (if any) to each vertex Position first.
+func (ct *canvasTriangles) Draw() {
+	transform := ct.canvas.transform
+	project := func(p Vec) Vec {
+		if transform == nil || transform.Len() == 0 {
+			return p
+		}
+		mat := transform.Peek()
+		vec := mgl32.Vec3{float32(p.X()), float32(p.Y()), 1}
+		pro := mat.Mul3x1(vec)
+		return V(float64(pro.X()), float64(pro.Y()))
+	}
+
+	for i := 0; i+3 <= ct.Len(); i += 3 {
+		ct.canvas.drawTriangle(
+			project(ct.Position(i+0)), ct.Color(i+0), ct.Texture(i+0),
+			project(ct.Position(i+1)), ct.Color(i+1), ct.Texture(i+1),
+			project(ct.Position(i+2)), ct.Color(i+2), ct.Texture(i+2),
+		)
+	}
+}
+
+// noTexture is the sentinel Texture value (set by MakeTrianglesData) meaning "no texture, use
+// the vertex Color only".
+var noTexture = V(-1, -1)
+
+// edge evaluates the edge function of the directed edge a->b at point p. Its sign indicates which
+// side of the edge p lies on; its magnitude is twice the area of the triangle a, b, p.
+func edge(a, b, p Vec) float64 {
+	return (p.X()-a.X())*(b.Y()-a.Y()) - (p.Y()-a.Y())*(b.X()-a.X())
+}
+
+// isTopLeft reports whether the directed edge a->b is a "top" or "left" edge of a triangle,
+// assuming a clockwise winding in a Y-up coordinate system. Pixels that lie exactly on such an
+// edge are considered inside; pixels on any other edge are not. This is the standard top-left
+// fill rule, and it guarantees that two triangles sharing an edge never draw the same pixel
+// twice.
+func isTopLeft(a, b Vec) bool {
+	isTop := a.Y() == b.Y() && b.X() > a.X()
+	isLeft := b.Y() > a.Y()
+	return isTop || isLeft
+}
+
+// drawTriangle rasterizes a single triangle, given as three (Position, Color, Texture) vertices,
+// into the Canvas using a per-triangle bounding-box scan and barycentric interpolation.
+func (c *Canvas) drawTriangle(
+	p0 Vec, c0 NRGBA, t0 Vec,
+	p1 Vec, c1 NRGBA, t1 Vec,
+	p2 Vec, c2 NRGBA, t2 Vec,
+) {
+	area := edge(p0, p1, p2)
+	if area == 0 {
+		return // degenerate triangle, nothing to draw
+	}
+
+	// Flip the winding's sign convention so that, from here on, positive edge values mean
+	// "inside" regardless of whether the triangle was specified clockwise or counter-clockwise.
+	if area < 0 {
+		p1, p2 = p2, p1
+		c1, c2 = c2, c1
+		t1, t2 = t2, t1
+		area = -area
+	}
+
+	top := c.bounds.Min.Add(c.bounds.Size)
+
+	minX := math.Max(math.Min(p0.X(), math.Min(p1.X(), p2.X())), c.bounds.Min.X())
+	maxX := math.Min(math.Max(p0.X(), math.Max(p1.X(), p2.X())), top.X())
+	minY := math.Max(math.Min(p0.Y(), math.Min(p1.Y(), p2.Y())), c.bounds.Min.Y())
+	maxY := math.Min(math.Max(p0.Y(), math.Max(p1.Y(), p2.Y())), top.Y())
+	if minX >= maxX || minY >= maxY {
+		return // triangle is entirely outside the canvas
+	}
+
+	top0, top1, top2 := isTopLeft(p1, p2), isTopLeft(p2, p0), isTopLeft(p0, p1)
+
+	textured := c.pic != nil && t0 != noTexture && t1 != noTexture && t2 != noTexture
+
+	for y := int(math.Floor(minY)); float64(y) < maxY; y++ {
+		for x := int(math.Floor(minX)); float64(x) < maxX; x++ {
+			p := V(float64(x)+0.5, float64(y)+0.5)
+
+			w0, w1, w2 := edge(p1, p2, p), edge(p2, p0, p), edge(p0, p1, p)
+			in0 := w0 > 0 || (w0 == 0 && top0)
+			in1 := w1 > 0 || (w1 == 0 && top1)
+			in2 := w2 > 0 || (w2 == 0 && top2)
+			if !in0 || !in1 || !in2 {
+				continue
+			}
+
+			b0, b1, b2 := w0/area, w1/area, w2/area
+
+			color := c0.Scaled(b0).Add(c1.Scaled(b1)).Add(c2.Scaled(b2))
+			if textured {
+				uv := V(
+					t0.X()*b0+t1.X()*b1+t2.X()*b2,
+					t0.Y()*b0+t1.Y()*b1+t2.Y()*b2,
+				)
+				color = color.Mul(c.sample(uv))
+			}
+
+			c.blend(x, y, color)
+		}
+	}
+}
+
+// sample looks up the color of c's Picture at normalized texture coordinates uv (0,0 to 1,1),
+// using the Canvas's current Filter.
+func (c *Canvas) sample(uv Vec) NRGBA {
+	pc, ok := interface{}(c.pic).(PictureColor)
+	if !ok {
+		return NRGBA{1, 1, 1, 1}
+	}
+	bounds := c.pic.Bounds()
+	at := bounds.Min.Add(V(uv.X()*bounds.Size.X(), uv.Y()*bounds.Size.Y()))
+
+	if c.filter.Kernel == nil {
+		x := math.Floor(at.X())
+		y := math.Floor(at.Y())
+		return pc.Color(V(x+0.5, y+0.5))
+	}
+	return sampleKernel(pc, bounds, at, *c.filter.Kernel)
+}
+
+// PictureColor is implemented by Pictures that can report the color of an individual pixel,
+// addressed in the Picture's own coordinate system (the same space as its Bounds). Canvas uses
+// this to sample textured Triangles entirely on the CPU. A Picture that doesn't implement it is
+// simply treated as untextured.
+type PictureColor interface {
+	Color(at Vec) NRGBA
+}
+
+// blend composites color over the pixel at (x, y) according to the Canvas's current CompositeOp
+// and writes the result back into the backing image. The Y axis is flipped, since the image's
+// origin is top-left while the Canvas's is bottom-left.
+func (c *Canvas) blend(x, y int, color NRGBA) {
+	iy := c.pix.Bounds().Max.Y - 1 - (y - int(c.bounds.Min.Y()))
+	ix := x - int(c.bounds.Min.X())
+	if ix < 0 || iy < 0 || ix >= c.pix.Bounds().Max.X || iy >= c.pix.Bounds().Max.Y {
+		return
+	}
+
+	i := c.pix.PixOffset(ix, iy)
+	dstA := float64(c.pix.Pix[i+3]) / 255
+	dstR := float64(c.pix.Pix[i+0]) / 255 * dstA
+	dstG := float64(c.pix.Pix[i+1]) / 255 * dstA
+	dstB := float64(c.pix.Pix[i+2]) / 255 * dstA
+
+	srcR, srcG, srcB := color.R*color.A, color.G*color.A, color.B*color.A
+
+	outR, outG, outB, outA := compose(c.composite, srcR, srcG, srcB, color.A, dstR, dstG, dstB, dstA)
+	if outA > 0 {
+		outR, outG, outB = outR/outA, outG/outA, outB/outA
+	}
+
+	c.pix.Pix[i+0] = uint8(clamp01(outR) * 255)
+	c.pix.Pix[i+1] = uint8(clamp01(outG) * 255)
+	c.pix.Pix[i+2] = uint8(clamp01(outB) * 255)
+	c.pix.Pix[i+3] = uint8(clamp01(outA) * 255)
+}
+
+// clamp01 clamps v into the range [0, 1].
+func clamp01(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
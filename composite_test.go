@@ -0,0 +1,42 @@
+package pixel
+
+import "testing"
+
+// TestCompose checks every CompositeOp against a fixed pair of translucent premultiplied inputs
+// (coverage 0.5 each) with known expected outputs, derived directly from the Porter-Duff algebra.
+func TestCompose(t *testing.T) {
+	const eps = 1e-9
+
+	// premultiplied translucent red (src) over premultiplied translucent blue (dst)
+	srcR, srcG, srcB, srcA := 0.5, 0.0, 0.0, 0.5
+	dstR, dstG, dstB, dstA := 0.0, 0.0, 0.5, 0.5
+
+	tests := []struct {
+		op         CompositeOp
+		r, g, b, a float64
+	}{
+		{CompositeOver, 0.5, 0, 0.25, 0.75},
+		{CompositeIn, 0.25, 0, 0, 0.25},
+		{CompositeOut, 0.25, 0, 0, 0.25},
+		{CompositeAtop, 0.25, 0, 0.25, 0.5},
+		{CompositeSrc, 0.5, 0, 0, 0.5},
+		{CompositeDst, 0, 0, 0.5, 0.5},
+		{CompositeXor, 0.25, 0, 0.25, 0.5},
+		{CompositePlus, 0.5, 0, 0.5, 1},
+	}
+
+	for _, want := range tests {
+		r, g, b, a := compose(want.op, srcR, srcG, srcB, srcA, dstR, dstG, dstB, dstA)
+		if abs(r-want.r) > eps || abs(g-want.g) > eps || abs(b-want.b) > eps || abs(a-want.a) > eps {
+			t.Errorf("compose(%v): got (%v,%v,%v,%v), want (%v,%v,%v,%v)",
+				want.op, r, g, b, a, want.r, want.g, want.b, want.a)
+		}
+	}
+}
+
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,134 @@
+package pixel
+
+import "math"
+
+// Filter controls how a Picture's pixels are resampled when a Sprite draws it at a different
+// size than its native resolution.
+//
+// FilterNearest and FilterBilinear are the common cheap cases. FilterKernel supports arbitrary
+// separable resampling kernels, mirroring the design of x/image/draw's Kernel: At returns the
+// kernel's weight at distance t (in source pixels), and is zero outside [-Support, Support].
+type Filter struct {
+	Kernel *FilterKernel
+}
+
+// FilterKernel is a separable resampling kernel: At(t) gives the kernel's weight at a distance of
+// t source pixels, and is assumed to be zero for |t| > Support.
+type FilterKernel struct {
+	Support float64
+	At      func(t float64) float64
+}
+
+// FilterNearest samples the single nearest source texel. It's the cheapest filter and the right
+// choice for upscaling pixel art without blurring it. It's also the zero value of Filter.
+var FilterNearest = Filter{}
+
+// FilterBilinear linearly interpolates between the four nearest source texels. It's a reasonable
+// general-purpose default.
+var FilterBilinear = Filter{Kernel: &ApproxBiLinear}
+
+// ApproxBiLinear is a FilterKernel approximating bilinear interpolation: a triangular weight
+// function with a support of 1 source pixel.
+var ApproxBiLinear = FilterKernel{
+	Support: 1,
+	At: func(t float64) float64 {
+		t = math.Abs(t)
+		if t >= 1 {
+			return 0
+		}
+		return 1 - t
+	},
+}
+
+// CatmullRom is a FilterKernel implementing the Catmull-Rom cubic spline, a good general-purpose
+// choice for downscaling photographic Pictures without the softness of bilinear filtering.
+var CatmullRom = FilterKernel{
+	Support: 2,
+	At: func(t float64) float64 {
+		t = math.Abs(t)
+		if t >= 2 {
+			return 0
+		}
+		if t <= 1 {
+			return (3*t*t*t - 5*t*t + 2) / 2
+		}
+		return (-t*t*t + 5*t*t - 8*t + 4) / 2
+	},
+}
+
+// SetFilter changes the Filter used to sample the Sprite's Picture.
+func (s *Sprite) SetFilter(f Filter) {
+	s.filter = f
+}
+
+// Filter returns the Sprite's current Filter.
+func (s *Sprite) Filter() Filter {
+	return s.filter
+}
+
+// WithFilter sets the Sprite's Filter and returns the Sprite, for chaining onto NewSprite.
+func (s *Sprite) WithFilter(f Filter) *Sprite {
+	s.SetFilter(f)
+	return s
+}
+
+// Filterable is implemented by Targets that support per-draw texture filtering. Sprite.Draw calls
+// SetFilter on the Target before drawing, if the Target implements this interface.
+type Filterable interface {
+	SetFilter(Filter)
+}
+
+// SetFilter changes the Filter used when this Canvas samples a textured Triangles's Picture.
+func (c *Canvas) SetFilter(f Filter) {
+	c.filter = f
+}
+
+// Filter returns the Canvas's current Filter.
+func (c *Canvas) Filter() Filter {
+	return c.filter
+}
+
+// sampleKernel samples pic at texture-space position at (in the Picture's own coordinate system,
+// the same space as Bounds) by convolving the given separable kernel over the texels within
+// [at-Support, at+Support] in each axis, normalizing the resulting weights.
+func sampleKernel(pc PictureColor, bounds Rect, at Vec, k FilterKernel) NRGBA {
+	x0 := int(math.Floor(at.X() - k.Support))
+	x1 := int(math.Ceil(at.X() + k.Support))
+	y0 := int(math.Floor(at.Y() - k.Support))
+	y1 := int(math.Ceil(at.Y() + k.Support))
+
+	var sum NRGBA
+	var weightSum float64
+
+	minX, minY := bounds.Min.XY()
+	maxX, maxY := bounds.Min.Add(bounds.Size).XY()
+
+	for y := y0; y <= y1; y++ {
+		fy := float64(y) + 0.5
+		if fy < minY || fy >= maxY {
+			continue
+		}
+		wy := k.At(fy - at.Y())
+		if wy == 0 {
+			continue
+		}
+		for x := x0; x <= x1; x++ {
+			fx := float64(x) + 0.5
+			if fx < minX || fx >= maxX {
+				continue
+			}
+			wx := k.At(fx - at.X())
+			if wx == 0 {
+				continue
+			}
+			w := wx * wy
+			sum = sum.Add(pc.Color(V(fx, fy)).Scaled(w))
+			weightSum += w
+		}
+	}
+
+	if weightSum == 0 {
+		return NRGBA{}
+	}
+	return sum.Scaled(1 / weightSum)
+}
@@ -0,0 +1,67 @@
+package pixel
+
+import "testing"
+
+// gridPicture is a minimal PictureColor backed by a dense grid of known colors, used to test
+// sampleKernel without depending on Picture's real (GPU-oriented) pixel storage.
+type gridPicture struct {
+	bounds Rect
+	at     func(x, y int) NRGBA
+}
+
+func (g gridPicture) Color(at Vec) NRGBA {
+	x, y := at.X(), at.Y()
+	return g.at(int(x), int(y))
+}
+
+func TestSampleKernelNearestLikeOnConstantPicture(t *testing.T) {
+	white := NRGBA{1, 1, 1, 1}
+	pic := gridPicture{
+		bounds: Rect{Min: V(0, 0), Size: V(4, 4)},
+		at:     func(x, y int) NRGBA { return white },
+	}
+
+	// A constant-color Picture must sample back to that same color everywhere, regardless of
+	// kernel, since every weighted texel is identical.
+	got := sampleKernel(pic, pic.bounds, V(2, 2), CatmullRom)
+	if got != white {
+		t.Fatalf("sampleKernel on constant picture = %+v, want %+v", got, white)
+	}
+}
+
+func TestSampleKernelAveragesNeighboringTexels(t *testing.T) {
+	// A 2x1 picture: black on the left, white on the right.
+	black, white := NRGBA{0, 0, 0, 1}, NRGBA{1, 1, 1, 1}
+	pic := gridPicture{
+		bounds: Rect{Min: V(0, 0), Size: V(2, 1)},
+		at: func(x, y int) NRGBA {
+			if x == 0 {
+				return black
+			}
+			return white
+		},
+	}
+
+	// Sampling exactly at the boundary between the two texels with ApproxBiLinear (support 1)
+	// should land roughly halfway between black and white.
+	got := sampleKernel(pic, pic.bounds, V(1, 0.5), ApproxBiLinear)
+	if got.R < 0.3 || got.R > 0.7 {
+		t.Fatalf("sampleKernel at texel boundary = %+v, want R roughly 0.5", got)
+	}
+}
+
+func TestSampleKernelClampsAtPictureEdge(t *testing.T) {
+	white := NRGBA{1, 1, 1, 1}
+	pic := gridPicture{
+		bounds: Rect{Min: V(0, 0), Size: V(2, 2)},
+		at:     func(x, y int) NRGBA { return white },
+	}
+
+	// Sampling right at the corner (support extends outside the Picture's bounds on two sides)
+	// must still normalize to the in-bounds color, not leak in zero-weight contributions from
+	// outside the Picture.
+	got := sampleKernel(pic, pic.bounds, V(0.25, 0.25), CatmullRom)
+	if got != white {
+		t.Fatalf("sampleKernel at picture corner = %+v, want %+v", got, white)
+	}
+}
@@ -160,9 +160,11 @@ func (td *TrianglesDrawer) Dirty() {
 // Sprite is a picture that can be drawn onto a Target. To change the position/rotation/scale of
 // the Sprite, use Target's SetTransform method.
 type Sprite struct {
-	data TrianglesData
-	td   TrianglesDrawer
-	pic  *Picture
+	data      TrianglesData
+	td        TrianglesDrawer
+	pic       *Picture
+	filter    Filter
+	composite CompositeOp
 }
 
 // NewSprite creates a Sprite with the supplied Picture. The dimensions of the returned Sprite match
@@ -177,6 +179,7 @@ func NewSprite(pic *Picture) *Sprite {
 			{Position: V(0, 0), Color: NRGBA{1, 1, 1, 1}, Texture: V(1, 1)},
 			{Position: V(0, 0), Color: NRGBA{1, 1, 1, 1}, Texture: V(0, 1)},
 		},
+		filter: FilterBilinear,
 	}
 	s.td = TrianglesDrawer{Triangles: &s.data}
 	s.SetPicture(pic)
@@ -208,14 +211,21 @@ func (s *Sprite) Picture() *Picture {
 // Draw draws the Sprite onto the provided Target.
 func (s *Sprite) Draw(t Target) {
 	t.SetPicture(s.pic)
+	if f, ok := t.(Filterable); ok {
+		f.SetFilter(s.filter)
+	}
+	if c, ok := t.(Composable); ok {
+		c.SetComposite(s.composite)
+	}
 	s.td.Draw(t)
 }
 
 // Polygon is a convex polygon shape filled with a single color.
 type Polygon struct {
-	data TrianglesData
-	td   TrianglesDrawer
-	col  NRGBA
+	data      TrianglesData
+	td        TrianglesDrawer
+	col       NRGBA
+	composite CompositeOp
 }
 
 // NewPolygon creates a Polygon with specified color and points. Points can be in clock-wise or
@@ -278,5 +288,8 @@ func (p *Polygon) Points() []Vec {
 // Draw draws the Polygon onto the Target.
 func (p *Polygon) Draw(t Target) {
 	t.SetPicture(nil)
+	if c, ok := t.(Composable); ok {
+		c.SetComposite(p.composite)
+	}
 	p.td.Draw(t)
 }
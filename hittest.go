@@ -0,0 +1,133 @@
+package pixel
+
+// Contains reports whether v lies inside the Polygon, by testing v against each fanned triangle
+// of the underlying TrianglesData for a consistent edge sign (the same test Canvas uses to
+// rasterize). This is cheap because the Polygon's convex shape is already triangle-fanned.
+func (p *Polygon) Contains(v Vec) bool {
+	for i := 0; i+3 <= p.data.Len(); i += 3 {
+		a, b, c := p.data[i].Position, p.data[i+1].Position, p.data[i+2].Position
+		area := edge(a, b, c)
+		if area == 0 {
+			continue
+		}
+		w0, w1, w2 := edge(b, c, v), edge(c, a, v), edge(a, b, v)
+		if area < 0 {
+			w0, w1, w2 = -w0, -w1, -w2
+		}
+		if w0 >= 0 && w1 >= 0 && w2 >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Bounds returns the axis-aligned bounding box of the Polygon's points, in its own local space.
+func (p *Polygon) Bounds() Rect {
+	return boundsOf(positions(p.data))
+}
+
+// TransformedBounds returns the axis-aligned bounding box of the Polygon's points after being
+// projected through t.
+func (p *Polygon) TransformedBounds(t Transform) Rect {
+	return boundsOf(project(positions(p.data), t))
+}
+
+// Bounds returns the axis-aligned bounding box of the Sprite's quad, in its own local space.
+func (s *Sprite) Bounds() Rect {
+	return boundsOf(positions(s.data))
+}
+
+// TransformedBounds returns the axis-aligned bounding box of the Sprite's quad after being
+// projected through t.
+func (s *Sprite) TransformedBounds(t Transform) Rect {
+	return boundsOf(project(positions(s.data), t))
+}
+
+// Contains reports whether v lies inside the Sprite's quad, in its own local space. It considers
+// the whole quad, including transparent pixels of the texture; use ContainsOpaque to test against
+// the Picture's alpha instead.
+func (s *Sprite) Contains(v Vec) bool {
+	return s.Bounds().Contains(v)
+}
+
+// ContainsOpaque reports whether v lies inside the Sprite's quad AND the Picture's sampled pixel
+// at the corresponding texture coordinate is not fully transparent. It returns false for points
+// outside the quad, and also for points inside the quad that land on a fully-transparent texel.
+// If the Picture doesn't support CPU sampling (doesn't implement PictureColor), it falls back to
+// Contains.
+func (s *Sprite) ContainsOpaque(v Vec) bool {
+	bounds := s.Bounds()
+	if !bounds.Contains(v) {
+		return false
+	}
+
+	pc, ok := interface{}(s.pic).(PictureColor)
+	if !ok || s.pic == nil {
+		return true
+	}
+
+	// Interpolate within the Sprite's own stored Texture coordinates (not the whole Picture),
+	// since a Spritesheet-backed Sprite's quad only covers a sub-rectangle of the atlas.
+	u := (v.X() - bounds.Min.X()) / bounds.Size.X()
+	vv := (v.Y() - bounds.Min.Y()) / bounds.Size.Y()
+	uv := spriteUV(s.data[0].Texture, s.data[2].Texture, u, vv)
+
+	pic := s.pic.Bounds()
+	at := pic.Min.Add(V(uv.X()*pic.Size.X(), uv.Y()*pic.Size.Y()))
+	return pc.Color(at).A > 0
+}
+
+// spriteUV maps a point expressed as a fraction (u, v) across a Sprite's local bounds (0,0 being
+// its bottom-left corner, 1,1 its top-right) into its Texture coordinate space, by interpolating
+// between the stored Texture values of its bottom-left (t0) and top-right (t2) corners. This is
+// what makes hit-testing work correctly against a Spritesheet-backed Sprite, whose Texture
+// coordinates only span a sub-rectangle of the atlas rather than the whole Picture.
+func spriteUV(t0, t2 Vec, u, v float64) Vec {
+	return V(
+		t0.X()+(t2.X()-t0.X())*u,
+		t0.Y()+(t2.Y()-t0.Y())*v,
+	)
+}
+
+// positions extracts the Position of every vertex in td.
+func positions(td TrianglesData) []Vec {
+	ps := make([]Vec, td.Len())
+	for i := range td {
+		ps[i] = td[i].Position
+	}
+	return ps
+}
+
+// project transforms every Vec in vs through t.
+func project(vs []Vec, t Transform) []Vec {
+	out := make([]Vec, len(vs))
+	for i, v := range vs {
+		out[i] = t.Project(v)
+	}
+	return out
+}
+
+// boundsOf returns the axis-aligned bounding box of vs. It returns the zero Rect if vs is empty.
+func boundsOf(vs []Vec) Rect {
+	if len(vs) == 0 {
+		return Rect{}
+	}
+	minX, minY := vs[0].XY()
+	maxX, maxY := minX, minY
+	for _, v := range vs[1:] {
+		x, y := v.XY()
+		if x < minX {
+			minX = x
+		}
+		if x > maxX {
+			maxX = x
+		}
+		if y < minY {
+			minY = y
+		}
+		if y > maxY {
+			maxY = y
+		}
+	}
+	return Rect{Min: V(minX, minY), Size: V(maxX-minX, maxY-minY)}
+}
@@ -0,0 +1,68 @@
+package pixel
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestPolygonContains(t *testing.T) {
+	// A non-axis-aligned convex quad (a diamond, rotated 45 degrees relative to the axes).
+	poly := NewPolygon(color.White, V(0, 2), V(2, 0), V(0, -2), V(-2, 0))
+
+	inside := []Vec{V(0, 0), V(0.5, 0.5), V(-1, 0)}
+	for _, v := range inside {
+		if !poly.Contains(v) {
+			t.Errorf("expected %v to be inside the diamond", v)
+		}
+	}
+
+	outside := []Vec{V(2, 2), V(0, 3), V(-3, 0), V(1.5, 1.5)}
+	for _, v := range outside {
+		if poly.Contains(v) {
+			t.Errorf("expected %v to be outside the diamond", v)
+		}
+	}
+}
+
+// TestSpriteUV checks the UV-interpolation math behind ContainsOpaque against a Sprite whose
+// Texture coordinates only span a sub-rectangle of its atlas, as produced by
+// Spritesheet.Sprite/Set. This is the computation that commit 331f54f fixed: before that fix, a
+// point in the second frame of a 2-frame atlas resolved to the frame boundary instead of the
+// frame's own center.
+func TestSpriteUV(t *testing.T) {
+	// Frame 1 of a 2-frame horizontal atlas: Texture coordinates [0.5, 1.0] x [0, 1].
+	t0, t2 := V(0.5, 0), V(1, 1)
+
+	// The center of the Sprite's local bounds (u=v=0.5) must map to the center of the frame's UV
+	// sub-rectangle (0.75, 0.5), not the whole atlas's center (0.5, 0.5).
+	got := spriteUV(t0, t2, 0.5, 0.5)
+	want := V(0.75, 0.5)
+	if got != want {
+		t.Fatalf("spriteUV(frame1 center) = %v, want %v", got, want)
+	}
+
+	// The corners should map exactly onto the frame's own corners.
+	if got := spriteUV(t0, t2, 0, 0); got != t0 {
+		t.Errorf("spriteUV(0,0) = %v, want %v", got, t0)
+	}
+	if got := spriteUV(t0, t2, 1, 1); got != t2 {
+		t.Errorf("spriteUV(1,1) = %v, want %v", got, t2)
+	}
+}
+
+func TestSpriteBoundsAndContains(t *testing.T) {
+	pic := &Picture{bounds: Rect{Min: V(0, 0), Size: V(10, 20)}}
+	sp := NewSprite(pic)
+
+	bounds := sp.Bounds()
+	if bounds.Min != V(0, 0) || bounds.Size != V(10, 20) {
+		t.Fatalf("Bounds() = %+v, want Min (0,0) Size (10,20)", bounds)
+	}
+
+	if !sp.Contains(V(5, 10)) {
+		t.Error("expected the center of the Sprite's quad to be Contains")
+	}
+	if sp.Contains(V(-1, 10)) {
+		t.Error("expected a point outside the Sprite's quad not to be Contains")
+	}
+}
@@ -0,0 +1,179 @@
+package pixel
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Frame describes one named subregion of a Spritesheet's Picture: a source rectangle in pixels,
+// plus a pivot (anchor) offset measured from the frame's bottom-left corner.
+type Frame struct {
+	Rect  Rect
+	Pivot Vec
+}
+
+// Spritesheet wraps a single *Picture (an atlas) together with a list of Frames addressing its
+// subregions, so many images can be packed into one texture and switching between them only
+// touches the affected Sprite's Texture coordinates, not its Picture.
+type Spritesheet struct {
+	pic    *Picture
+	frames []Frame
+}
+
+// NewSpritesheetGrid slices pic into a uniform grid of cols x rows cells, each of size cellSize,
+// starting at pic's origin and proceeding left-to-right, bottom-to-top. Frames are indexed
+// row-major starting from the bottom row.
+func NewSpritesheetGrid(pic *Picture, cellSize Vec, cols, rows int) *Spritesheet {
+	frames := make([]Frame, 0, cols*rows)
+	origin := pic.Bounds().Min
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			min := origin.Add(V(float64(col)*cellSize.X(), float64(row)*cellSize.Y()))
+			frames = append(frames, Frame{
+				Rect: Rect{Min: min, Size: cellSize},
+			})
+		}
+	}
+	return NewSpritesheet(pic, frames)
+}
+
+// NewSpritesheet wraps pic together with an explicit list of Frames.
+func NewSpritesheet(pic *Picture, frames []Frame) *Spritesheet {
+	return &Spritesheet{pic: pic, frames: frames}
+}
+
+// spritesheetFrameJSON mirrors the TexturePacker/Kenney-style JSON layout:
+//
+//	{"frame": {"x":0,"y":0,"w":16,"h":16}, "sourceSize": {"w":16,"h":16}, "pivot": {"x":0.5,"y":0.5}}
+//
+// pivot is given in normalized [0,1] frame coordinates, as TexturePacker does, and is converted to
+// pixel offsets from the frame's bottom-left corner when loaded.
+type spritesheetFrameJSON struct {
+	Frame struct {
+		X, Y, W, H float64
+	} `json:"frame"`
+	SourceSize struct {
+		W, H float64
+	} `json:"sourceSize"`
+	Pivot struct {
+		X, Y float64
+	} `json:"pivot"`
+}
+
+// LoadSpritesheetJSON parses a TexturePacker/Kenney-style JSON atlas description (a bare array of
+// frame objects) and builds a Spritesheet against pic.
+//
+// The JSON's coordinates are assumed to use a top-left origin and a Y-down axis, as TexturePacker
+// does; they are flipped into pic's bottom-left-origin, Y-up space using pic's Bounds.
+func LoadSpritesheetJSON(pic *Picture, data []byte) (*Spritesheet, error) {
+	var raw []spritesheetFrameJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	top := pic.Bounds().Min.Y() + pic.Bounds().Size.Y()
+
+	frames := make([]Frame, len(raw))
+	for i, rf := range raw {
+		min := V(pic.Bounds().Min.X()+rf.Frame.X, top-rf.Frame.Y-rf.Frame.H)
+		size := V(rf.Frame.W, rf.Frame.H)
+		frames[i] = Frame{
+			Rect:  Rect{Min: min, Size: size},
+			Pivot: V(rf.Pivot.X*rf.SourceSize.W, (1-rf.Pivot.Y)*rf.SourceSize.H),
+		}
+	}
+	return NewSpritesheet(pic, frames), nil
+}
+
+// Len returns the number of Frames in the Spritesheet.
+func (s *Spritesheet) Len() int {
+	return len(s.frames)
+}
+
+// Frame returns the frameIndex-th Frame.
+func (s *Spritesheet) Frame(frameIndex int) Frame {
+	return s.frames[frameIndex]
+}
+
+// Picture returns the Spritesheet's underlying atlas Picture.
+func (s *Spritesheet) Picture() *Picture {
+	return s.pic
+}
+
+// Sprite creates a new Sprite showing the frameIndex-th Frame of the Spritesheet.
+func (s *Spritesheet) Sprite(frameIndex int) *Sprite {
+	sp := NewSprite(s.pic)
+	s.apply(sp, frameIndex)
+	return sp
+}
+
+// Set rewrites sp's Texture coordinates (and dimensions) to show the frameIndex-th Frame,
+// reusing sp's existing Picture and TrianglesData. This is the O(6) path for switching animation
+// frames, as opposed to building a new Sprite from scratch.
+func (s *Spritesheet) Set(sp *Sprite, frameIndex int) {
+	s.apply(sp, frameIndex)
+}
+
+// apply rewrites sp's vertex Positions and Texture coordinates to match the given Frame.
+func (s *Spritesheet) apply(sp *Sprite, frameIndex int) {
+	f := s.frames[frameIndex]
+
+	atlas := s.pic.Bounds()
+	u0 := (f.Rect.Min.X() - atlas.Min.X()) / atlas.Size.X()
+	v0 := (f.Rect.Min.Y() - atlas.Min.Y()) / atlas.Size.Y()
+	u1 := (f.Rect.Min.X() + f.Rect.Size.X() - atlas.Min.X()) / atlas.Size.X()
+	v1 := (f.Rect.Min.Y() + f.Rect.Size.Y() - atlas.Min.Y()) / atlas.Size.Y()
+
+	w, h := f.Rect.Size.XY()
+	sp.data[0].Position = V(0, 0).Sub(f.Pivot)
+	sp.data[1].Position = V(w, 0).Sub(f.Pivot)
+	sp.data[2].Position = V(w, h).Sub(f.Pivot)
+	sp.data[3].Position = V(0, 0).Sub(f.Pivot)
+	sp.data[4].Position = V(w, h).Sub(f.Pivot)
+	sp.data[5].Position = V(0, h).Sub(f.Pivot)
+
+	sp.data[0].Texture = V(u0, v0)
+	sp.data[1].Texture = V(u1, v0)
+	sp.data[2].Texture = V(u1, v1)
+	sp.data[3].Texture = V(u0, v0)
+	sp.data[4].Texture = V(u1, v1)
+	sp.data[5].Texture = V(u0, v1)
+
+	sp.td.Dirty()
+}
+
+// Animation is a Drawer that cycles through a sequence of a Spritesheet's Frames over time,
+// advancing to the next frame every 1/fps seconds on each Draw.
+type Animation struct {
+	sheet   *Spritesheet
+	indices []int
+	fps     float64
+	sprite  *Sprite
+
+	start time.Time
+}
+
+// Animation returns an Animation over the given frame indices of the Spritesheet, played back at
+// fps frames per second. Playback starts the first time Draw is called.
+func (s *Spritesheet) Animation(indices []int, fps float64) *Animation {
+	return &Animation{
+		sheet:   s,
+		indices: indices,
+		fps:     fps,
+		sprite:  s.Sprite(indices[0]),
+	}
+}
+
+// Draw advances the Animation to the frame appropriate for the current time, then draws it onto
+// target.
+func (a *Animation) Draw(target Target) {
+	if a.start.IsZero() {
+		a.start = time.Now()
+	}
+
+	elapsed := time.Since(a.start).Seconds()
+	frame := int(elapsed*a.fps) % len(a.indices)
+	a.sheet.Set(a.sprite, a.indices[frame])
+
+	a.sprite.Draw(target)
+}
@@ -0,0 +1,67 @@
+package pixel
+
+import "testing"
+
+func TestNewSpritesheetGridFrameGeometry(t *testing.T) {
+	pic := &Picture{bounds: Rect{Min: V(0, 0), Size: V(20, 10)}}
+	sheet := NewSpritesheetGrid(pic, V(10, 10), 2, 1)
+
+	if sheet.Len() != 2 {
+		t.Fatalf("got %d frames, want 2", sheet.Len())
+	}
+
+	f0, f1 := sheet.Frame(0), sheet.Frame(1)
+	if f0.Rect.Min != V(0, 0) {
+		t.Errorf("frame 0 Min = %v, want (0,0)", f0.Rect.Min)
+	}
+	if f1.Rect.Min != V(10, 0) {
+		t.Errorf("frame 1 Min = %v, want (10,0)", f1.Rect.Min)
+	}
+	if f0.Rect.Size != V(10, 10) || f1.Rect.Size != V(10, 10) {
+		t.Errorf("frame sizes = %v, %v, want (10,10) each", f0.Rect.Size, f1.Rect.Size)
+	}
+}
+
+// TestLoadSpritesheetJSONFrameRect checks that a frame's TexturePacker top-left/Y-down rectangle
+// is correctly flipped into the atlas's bottom-left/Y-up space.
+func TestLoadSpritesheetJSONFrameRect(t *testing.T) {
+	pic := &Picture{bounds: Rect{Min: V(0, 0), Size: V(100, 100)}}
+
+	data := []byte(`[
+		{"frame":{"x":0,"y":0,"w":50,"h":50},"sourceSize":{"w":50,"h":50},"pivot":{"x":0,"y":0}}
+	]`)
+	sheet, err := LoadSpritesheetJSON(pic, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// TexturePacker's y=0 is the *top* of the atlas, which in this codebase's bottom-left,
+	// Y-up space is y=50 (the atlas is 100 tall and the frame is 50 tall).
+	got := sheet.Frame(0).Rect.Min
+	want := V(0, 50)
+	if got != want {
+		t.Errorf("frame Rect.Min = %v, want %v", got, want)
+	}
+}
+
+// TestLoadSpritesheetJSONPivot checks that a pivot given in TexturePacker's normalized,
+// top-left-origin fraction is flipped into this codebase's bottom-left-origin Pivot offset.
+func TestLoadSpritesheetJSONPivot(t *testing.T) {
+	pic := &Picture{bounds: Rect{Min: V(0, 0), Size: V(100, 100)}}
+
+	data := []byte(`[
+		{"frame":{"x":0,"y":0,"w":100,"h":100},"sourceSize":{"w":100,"h":100},"pivot":{"x":0.5,"y":1.0}}
+	]`)
+	sheet, err := LoadSpritesheetJSON(pic, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// pivot (0.5, 1.0) is TexturePacker's "bottom-center" (feet), i.e. y=0 in this codebase's
+	// bottom-left-origin Pivot space, not y=100.
+	got := sheet.Frame(0).Pivot
+	want := V(50, 0)
+	if got != want {
+		t.Errorf("Pivot = %v, want %v (bottom-center, not top)", got, want)
+	}
+}
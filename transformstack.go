@@ -0,0 +1,91 @@
+package pixel
+
+import (
+	"fmt"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// TransformStack is a stack of Transforms, composed together, for rendering hierarchical scenes
+// (for example a turret on a tank on a moving platform). Push a node's local Transform, draw the
+// node's children against Peek, then Pop it before moving on to a sibling.
+//
+// The zero value of TransformStack is an empty stack, equivalent to having pushed ZT.
+type TransformStack struct {
+	stack []Transform
+}
+
+// NewTransformStack creates an empty TransformStack.
+func NewTransformStack() *TransformStack {
+	return &TransformStack{}
+}
+
+// Push appends t to the top of the stack.
+func (ts *TransformStack) Push(t Transform) {
+	ts.stack = append(ts.stack, t)
+}
+
+// Pop removes and returns the Transform on top of the stack.
+//
+// Pop returns an error if the stack is empty.
+func (ts *TransformStack) Pop() (Transform, error) {
+	if len(ts.stack) == 0 {
+		return Transform{}, fmt.Errorf("TransformStack.Pop: stack is empty")
+	}
+	t := ts.stack[len(ts.stack)-1]
+	ts.stack = ts.stack[:len(ts.stack)-1]
+	return t, nil
+}
+
+// Len returns the number of Transforms currently on the stack.
+func (ts *TransformStack) Len() int {
+	return len(ts.stack)
+}
+
+// Peek returns the fully composed matrix of every Transform on the stack, parent first. An empty
+// stack composes to the identity matrix.
+func (ts *TransformStack) Peek() mgl32.Mat3 {
+	mat := mgl32.Ident3()
+	for _, t := range ts.stack {
+		mat = mat.Mul3(t.Mat())
+	}
+	return mat
+}
+
+// Copy returns an independent copy of this TransformStack.
+func (ts *TransformStack) Copy() *TransformStack {
+	cp := &TransformStack{stack: make([]Transform, len(ts.stack))}
+	copy(cp.stack, ts.stack)
+	return cp
+}
+
+// Rebase returns the matrix that maps coordinates from other's frame into this stack's frame,
+// i.e. this.Peek().Inv().Mul3(other.Peek()). This lets two independent subtrees compute their
+// relative transform without either needing to know their common ancestor.
+func (ts *TransformStack) Rebase(other *TransformStack) mgl32.Mat3 {
+	return ts.Peek().Inv().Mul3(other.Peek())
+}
+
+// Transformable is implemented by Targets that support hierarchical transforms driven by a
+// TransformStack, as an alternative to composing a single Transform by hand before every Draw.
+type Transformable interface {
+	PushTransform(Transform)
+	PopTransform() (Transform, error)
+}
+
+// PushTransform pushes t onto the Canvas's internal TransformStack. Every Triangles drawn
+// afterwards is transformed by the stack's composed matrix, until a matching PopTransform.
+func (c *Canvas) PushTransform(t Transform) {
+	if c.transform == nil {
+		c.transform = NewTransformStack()
+	}
+	c.transform.Push(t)
+}
+
+// PopTransform removes and returns the most recently pushed Transform.
+func (c *Canvas) PopTransform() (Transform, error) {
+	if c.transform == nil {
+		return Transform{}, fmt.Errorf("Canvas.PopTransform: stack is empty")
+	}
+	return c.transform.Pop()
+}
@@ -0,0 +1,60 @@
+package pixel
+
+import (
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func projectMat(mat mgl32.Mat3, x, y float64) (float64, float64) {
+	v := mat.Mul3x1(mgl32.Vec3{float32(x), float32(y), 1})
+	return float64(v.X()), float64(v.Y())
+}
+
+func TestTransformStackPeekComposesParentFirst(t *testing.T) {
+	ts := NewTransformStack()
+	ts.Push(Position(V(10, 0)).Scale(2))
+	ts.Push(Position(V(0, 5)))
+
+	// A child pushed after the parent is applied first, inside the parent's frame: local (0,0)
+	// moves to the child's (0, 5), which the parent then scales to (0, 10) and moves to (10, 10).
+	// Composing in the opposite order would give (10, 5) instead.
+	x, y := projectMat(ts.Peek(), 0, 0)
+	if x != 10 || y != 10 {
+		t.Fatalf("Peek() composed to (%v, %v), want (10, 10)", x, y)
+	}
+}
+
+// TestTransformStackRebase checks that Rebase produces the matrix that maps a point from one
+// subtree's local frame into another's, by round-tripping through world space: the same world
+// point, expressed first via `other`'s stack and then rebased into `this`'s stack, must match
+// what `this`'s stack produces directly.
+func TestTransformStackRebase(t *testing.T) {
+	this := NewTransformStack()
+	this.Push(Position(V(0, 5)))
+
+	other := NewTransformStack()
+	other.Push(Position(V(10, 0)))
+
+	// The point (0,0) in other's local frame is other's origin, which sits at world (10,0).
+	wantX, wantY := projectMat(other.Peek(), 0, 0)
+
+	rebase := this.Rebase(other)
+	gotX, gotY := projectMat(rebase, 0, 0)
+
+	// gotX,gotY is (0,0) expressed in this's local frame; projecting it through this's own
+	// matrix must land back on the same world point that other's stack produced.
+	worldX, worldY := projectMat(this.Peek(), gotX, gotY)
+	if !f32eq(worldX, wantX) || !f32eq(worldY, wantY) {
+		t.Fatalf("Rebase round-trip landed on world (%v, %v), want (%v, %v)", worldX, worldY, wantX, wantY)
+	}
+}
+
+func f32eq(a, b float64) bool {
+	const eps = 1e-4
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	return d < eps
+}